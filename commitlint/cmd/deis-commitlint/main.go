@@ -0,0 +1,168 @@
+// Command deis-commitlint runs the Deis commit style guide (package
+// commitlint) from the command line, either over a range of existing
+// commits or against a single pending commit message. It can also install
+// itself as a `commit-msg` git hook, so contributors learn about style
+// violations before the commit is made instead of after.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/deis/deis/commitlint"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "install-hook" {
+		if err := installHook(); err != nil {
+			fmt.Fprintln(os.Stderr, "deis-commitlint:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	configPath := flag.String("config", commitlint.DefaultConfigPath, "path to the commitlint config file")
+	msgFile := flag.String("msg-file", "", "lint a single pending commit message from this file, e.g. .git/COMMIT_EDITMSG")
+	flag.Parse()
+
+	cfg, err := commitlint.LoadConfig(*configPath)
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Fprintln(os.Stderr, "deis-commitlint:", err)
+		os.Exit(1)
+	}
+
+	var issues []commitlint.Issue
+	if *msgFile != "" {
+		issues, err = lintMsgFile(cfg, *msgFile)
+	} else {
+		issues, err = lintLog(cfg)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "deis-commitlint:", err)
+		os.Exit(1)
+	}
+
+	for _, issue := range issues {
+		fmt.Fprintln(os.Stderr, issue.String())
+	}
+	if len(issues) > 0 {
+		os.Exit(1)
+	}
+}
+
+// lintLog runs the configured rule set over the commit range in cfg.
+func lintLog(cfg commitlint.Config) ([]commitlint.Issue, error) {
+	rules, err := cfg.Rules()
+	if err != nil {
+		return nil, err
+	}
+	filter, err := cfg.Filter()
+	if err != nil {
+		return nil, err
+	}
+	commits, err := commitlint.LoadCommits(cfg.Since, cfg.Until)
+	if err != nil {
+		return nil, err
+	}
+	return commitlint.Run(filter.Apply(commits), rules), nil
+}
+
+// lintMsgFile builds a synthetic Commit from a pending commit message file
+// and runs the configured rule set against it. The commit has no hash yet
+// and no parents, so range-only rules (GPG) are skipped.
+func lintMsgFile(cfg commitlint.Config, path string) ([]commitlint.Issue, error) {
+	rules, err := cfg.Rules()
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	commit, err := synthesizeCommit(string(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []commitlint.Issue
+	for _, rule := range rules {
+		if _, ok := rule.(commitlint.GPG); ok {
+			continue
+		}
+		issues = append(issues, rule.Check(commit)...)
+	}
+	return issues, nil
+}
+
+// synthesizeCommit builds a Commit from a raw commit message, with author
+// identity pulled from the local git config since the commit hasn't been
+// created yet.
+func synthesizeCommit(message string) (commitlint.Commit, error) {
+	message = stripCommentLines(message)
+	lines := strings.SplitN(strings.TrimRight(message, "\n"), "\n", 2)
+
+	commit := commitlint.Commit{Subject: lines[0]}
+	if len(lines) > 1 {
+		commit.Body = strings.TrimLeft(lines[1], "\n")
+	}
+
+	name, err := gitConfig("user.name")
+	if err != nil {
+		return commit, err
+	}
+	email, err := gitConfig("user.email")
+	if err != nil {
+		return commit, err
+	}
+	commit.Author = commitlint.Identity{Name: name, Email: email}
+	return commit, nil
+}
+
+// stripCommentLines drops `#`-prefixed lines, the same way git itself
+// ignores them when it loads a commit message from COMMIT_EDITMSG.
+func stripCommentLines(message string) string {
+	var kept []string
+	for _, line := range strings.Split(message, "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return strings.Join(kept, "\n")
+}
+
+func gitConfig(key string) (string, error) {
+	out, err := exec.Command("git", "config", key).Output()
+	if err != nil {
+		return "", fmt.Errorf("git config %s: %s", key, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+const hookScript = `#!/bin/sh
+# Installed by "deis-commitlint install-hook". Lints the pending commit
+# message against Deis' commit style guide before the commit is made.
+exec deis-commitlint --msg-file="$1"
+`
+
+// installHook writes a commit-msg hook into .git/hooks that invokes this
+// binary against the pending commit message.
+func installHook() error {
+	out, err := exec.Command("git", "rev-parse", "--git-dir").Output()
+	if err != nil {
+		return fmt.Errorf("not a git repository: %s", err)
+	}
+	gitDir := strings.TrimSpace(string(out))
+	hookPath := gitDir + "/hooks/commit-msg"
+
+	if err := ioutil.WriteFile(hookPath, []byte(hookScript), 0755); err != nil {
+		return err
+	}
+	fmt.Println("installed commit-msg hook at", hookPath)
+	return nil
+}
@@ -0,0 +1,29 @@
+package commitlint
+
+import (
+	"strings"
+
+	"github.com/deis/deis/commitlint/gitparse"
+)
+
+// Commit is the commit type every rule operates on. It is an alias for
+// gitparse.Commit so rules get full author/committer/date/parent
+// information, not just a subject and body.
+type Commit = gitparse.Commit
+
+// Identity is the name/email pair of a commit's author or committer.
+type Identity = gitparse.Identity
+
+// Message reconstructs the full commit message from a commit's subject
+// and body.
+func Message(c Commit) string {
+	if c.Body == "" {
+		return c.Subject
+	}
+	return c.Subject + "\n" + c.Body
+}
+
+// Lines returns the full commit message split into individual lines.
+func Lines(c Commit) []string {
+	return strings.Split(Message(c), "\n")
+}
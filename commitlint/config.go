@@ -0,0 +1,248 @@
+package commitlint
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultConfigPath is the file the runner looks for in the repo root.
+const DefaultConfigPath = ".deis-commitlint"
+
+// Config holds the settings needed to build the rule set enforced against
+// a range of commits. DefaultConfig returns the settings matching Deis'
+// original, hard-coded style guide; a zero-value Config enables no rules.
+type Config struct {
+	SubjectRegex      string
+	SubjectMaxLen     int
+	SubjectMinLen     int
+	BodyRegex         string
+	BodyMaxLen        int
+	ConventionalTypes []string
+
+	// Since/Until bound the range of commits that get linted at all,
+	// in the format accepted by `git log --after`/`--before`.
+	Since string
+	Until string
+
+	// ExcludeAuthorNames/ExcludeAuthorEmails are comma-separated regex
+	// lists. Commits whose author matches one are skipped entirely,
+	// e.g. to exempt dependency-update bots.
+	ExcludeAuthorNames  []string
+	ExcludeAuthorEmails []string
+	// MaxParents excludes commits with more than this many parents.
+	// Defaults to 1, which drops merge commits the same way
+	// `git log --no-merges` used to.
+	MaxParents int
+
+	// ImperativeMood toggles the rule rejecting non-imperative subject
+	// verbs ("added" instead of "add"). Deis' guide only enforces the
+	// conventional prefix today, so this defaults to off.
+	ImperativeMood bool
+	// ImperativeAllowlist names words that should never be flagged by
+	// ImperativeMood, e.g. "wed" or "bed", which end in "ed"/"ing" but
+	// aren't past-tense verbs.
+	ImperativeAllowlist []string
+
+	// DCO toggles the Signed-off-by trailer check.
+	DCO bool
+	// GPG toggles the commit signature check, and GPGAllowUnknown
+	// relaxes it to accept a good signature of unknown validity.
+	GPG             bool
+	GPGAllowUnknown bool
+}
+
+// DefaultConfig reproduces the policy TestCommitsAdhereToCommitStyleGuide
+// used to enforce before it was made configurable.
+func DefaultConfig() Config {
+	return Config{
+		SubjectRegex:  `^[a-z]+\(.+\): [0-9a-z].+[^.]$`,
+		SubjectMaxLen: 50,
+		BodyMaxLen:    72,
+		ConventionalTypes: []string{
+			"feat", "fix", "docs", "style", "ref", "test", "chore",
+		},
+		Since:      PolicyDate,
+		MaxParents: 1,
+	}
+}
+
+// LoadConfig reads a `key: value` config file such as .deis-commitlint.
+// Blank lines and lines starting with `#` are ignored. Missing keys fall
+// back to DefaultConfig.
+func LoadConfig(path string) (Config, error) {
+	cfg := DefaultConfig()
+
+	f, err := os.Open(path)
+	if err != nil {
+		return cfg, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return cfg, fmt.Errorf("%s: malformed line %q", path, line)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "subject-regex":
+			cfg.SubjectRegex = value
+		case "subject-max-len":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return cfg, fmt.Errorf("%s: %s", path, err)
+			}
+			cfg.SubjectMaxLen = n
+		case "subject-min-len":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return cfg, fmt.Errorf("%s: %s", path, err)
+			}
+			cfg.SubjectMinLen = n
+		case "body-regex":
+			cfg.BodyRegex = value
+		case "body-max-len":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return cfg, fmt.Errorf("%s: %s", path, err)
+			}
+			cfg.BodyMaxLen = n
+		case "conventional-types":
+			cfg.ConventionalTypes = splitTrimmed(value)
+		case "since":
+			cfg.Since = value
+		case "until":
+			cfg.Until = value
+		case "exclude-author-names":
+			cfg.ExcludeAuthorNames = splitTrimmed(value)
+		case "exclude-author-emails":
+			cfg.ExcludeAuthorEmails = splitTrimmed(value)
+		case "max-parents":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return cfg, fmt.Errorf("%s: %s", path, err)
+			}
+			cfg.MaxParents = n
+		case "imperative-mood":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return cfg, fmt.Errorf("%s: %s", path, err)
+			}
+			cfg.ImperativeMood = b
+		case "imperative-allowlist":
+			cfg.ImperativeAllowlist = splitTrimmed(value)
+		case "dco":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return cfg, fmt.Errorf("%s: %s", path, err)
+			}
+			cfg.DCO = b
+		case "gpg":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return cfg, fmt.Errorf("%s: %s", path, err)
+			}
+			cfg.GPG = b
+		case "gpg-allow-unknown":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return cfg, fmt.Errorf("%s: %s", path, err)
+			}
+			cfg.GPGAllowUnknown = b
+		default:
+			return cfg, fmt.Errorf("%s: unknown setting %q", path, key)
+		}
+	}
+	return cfg, scanner.Err()
+}
+
+// splitTrimmed splits a comma-separated config value, trimming whitespace
+// around each element and dropping empty ones.
+func splitTrimmed(value string) []string {
+	var out []string
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Filter builds the commit filter described by the config.
+func (cfg Config) Filter() (Filter, error) {
+	f := Filter{MaxParents: cfg.MaxParents}
+
+	for _, pattern := range cfg.ExcludeAuthorNames {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return f, fmt.Errorf("exclude-author-names: %s", err)
+		}
+		f.ExcludeAuthorNames = append(f.ExcludeAuthorNames, re)
+	}
+	for _, pattern := range cfg.ExcludeAuthorEmails {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return f, fmt.Errorf("exclude-author-emails: %s", err)
+		}
+		f.ExcludeAuthorEmails = append(f.ExcludeAuthorEmails, re)
+	}
+	return f, nil
+}
+
+// Rules builds the rule set described by the config.
+func (cfg Config) Rules() ([]Rule, error) {
+	var rules []Rule
+
+	if cfg.SubjectRegex != "" {
+		pattern, err := regexp.Compile(cfg.SubjectRegex)
+		if err != nil {
+			return nil, fmt.Errorf("subject-regex: %s", err)
+		}
+		rules = append(rules, SubjectRegex{Pattern: pattern})
+	}
+	if cfg.SubjectMaxLen > 0 {
+		rules = append(rules, SubjectMaxLen{Max: cfg.SubjectMaxLen})
+	}
+	if cfg.SubjectMinLen > 0 {
+		rules = append(rules, SubjectMinLen{Min: cfg.SubjectMinLen})
+	}
+	if cfg.BodyRegex != "" {
+		pattern, err := regexp.Compile(cfg.BodyRegex)
+		if err != nil {
+			return nil, fmt.Errorf("body-regex: %s", err)
+		}
+		rules = append(rules, BodyRegex{Pattern: pattern})
+	}
+	if cfg.BodyMaxLen > 0 {
+		rules = append(rules, BodyMaxLen{Max: cfg.BodyMaxLen})
+	}
+	if len(cfg.ConventionalTypes) > 0 {
+		rules = append(rules, ConventionalType{Types: cfg.ConventionalTypes})
+	}
+	if cfg.ImperativeMood {
+		rules = append(rules, ImperativeMood{Allowlist: cfg.ImperativeAllowlist})
+	}
+	if cfg.DCO {
+		rules = append(rules, DCO{})
+	}
+	if cfg.GPG {
+		statuses, err := LoadSignatures(cfg.Since, cfg.Until)
+		if err != nil {
+			return nil, fmt.Errorf("gpg: %s", err)
+		}
+		rules = append(rules, GPG{Statuses: statuses, AllowUnknown: cfg.GPGAllowUnknown})
+	}
+	return rules, nil
+}
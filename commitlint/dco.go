@@ -0,0 +1,37 @@
+package commitlint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var signedOffBy = regexp.MustCompile(`(?m)^Signed-off-by: .+ <(.+)>\s*$`)
+
+// DCO requires every non-merge commit's body to carry a
+// `Signed-off-by: Name <email>` trailer whose email matches the commit's
+// author email, case-insensitively. It is opt-in, since not every
+// contributor signs off from day one.
+type DCO struct{}
+
+// Name implements Rule.
+func (r DCO) Name() string { return "dco" }
+
+// Check implements Rule.
+func (r DCO) Check(c Commit) []Issue {
+	if len(c.Parents) > 1 {
+		return nil
+	}
+
+	matches := signedOffBy.FindAllStringSubmatch(Message(c), -1)
+	for _, m := range matches {
+		if strings.EqualFold(m[1], c.Author.Email) {
+			return nil
+		}
+	}
+	return []Issue{{
+		Hash:    c.Hash,
+		Rule:    r.Name(),
+		Message: fmt.Sprintf("missing Signed-off-by trailer for %s", c.Author.Email),
+	}}
+}
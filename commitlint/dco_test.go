@@ -0,0 +1,25 @@
+package commitlint
+
+import "testing"
+
+func TestDCO(t *testing.T) {
+	rule := DCO{}
+
+	signedOff := Commit{
+		Author: Identity{Email: "jane@example.com"},
+		Body:   "Signed-off-by: Jane Doe <jane@example.com>",
+	}
+	if issues := rule.Check(signedOff); len(issues) != 0 {
+		t.Errorf("expected no issues, got %v", issues)
+	}
+
+	missing := Commit{Author: Identity{Email: "jane@example.com"}}
+	if issues := rule.Check(missing); len(issues) == 0 {
+		t.Errorf("expected an issue for a missing Signed-off-by trailer")
+	}
+
+	merge := Commit{Parents: []string{"a", "b"}}
+	if issues := rule.Check(merge); len(issues) != 0 {
+		t.Errorf("expected merge commits to be exempt, got %v", issues)
+	}
+}
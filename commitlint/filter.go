@@ -0,0 +1,46 @@
+package commitlint
+
+import "regexp"
+
+// Filter decides which commits are subject to linting at all, before any
+// rule gets a chance to run. This lets Deis exempt bot commits (dependency
+// update bots, release tagging automation, ...) without weakening the
+// style policy for humans.
+type Filter struct {
+	// ExcludeAuthorNames/ExcludeAuthorEmails are regexes matched against a
+	// commit's author name/email. A match excludes the commit.
+	ExcludeAuthorNames  []*regexp.Regexp
+	ExcludeAuthorEmails []*regexp.Regexp
+	// MaxParents excludes commits with more than this many parents, e.g.
+	// 1 to drop merge commits. Zero means unlimited.
+	MaxParents int
+}
+
+// Apply returns the subset of commits that should be linted.
+func (f Filter) Apply(commits []Commit) []Commit {
+	var kept []Commit
+	for _, c := range commits {
+		if f.excluded(c) {
+			continue
+		}
+		kept = append(kept, c)
+	}
+	return kept
+}
+
+func (f Filter) excluded(c Commit) bool {
+	if f.MaxParents > 0 && len(c.Parents) > f.MaxParents {
+		return true
+	}
+	for _, re := range f.ExcludeAuthorNames {
+		if re.MatchString(c.Author.Name) {
+			return true
+		}
+	}
+	for _, re := range f.ExcludeAuthorEmails {
+		if re.MatchString(c.Author.Email) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+// Package gitparse turns `git log` output into typed Commit values, so
+// callers can reason about author identity, commit dates, and parentage
+// instead of re-parsing raw strings themselves.
+package gitparse
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// format is fed to `git log --format`. Fields are separated with ASCII
+// unit separators (0x1f) and records with ASCII record separators (0x1e),
+// so that multi-line subjects/bodies can't be confused with field or
+// record boundaries.
+const format = "%H%x1f%an%x1f%ae%x1f%cn%x1f%ce%x1f%aI%x1f%cI%x1f%P%x1f%s%x1f%B%x1e"
+
+const fieldCount = 10
+
+// Identity is the name/email pair git records for an author or committer.
+type Identity struct {
+	Name  string
+	Email string
+}
+
+// Commit is a single commit parsed out of `git log`.
+type Commit struct {
+	Hash string
+
+	Author    Identity
+	Committer Identity
+
+	AuthorDate    time.Time
+	CommitterDate time.Time
+
+	// Parents holds the commit's parent hashes. Zero parents means a root
+	// commit; more than one means a merge commit.
+	Parents []string
+
+	Subject string
+	// Body is the full commit message minus its subject line, with the
+	// blank separator line removed.
+	Body string
+}
+
+// Load runs `git log` over the given range and returns the parsed
+// commits. An empty `since`/`until` leaves that bound open; args are
+// appended verbatim to the `git log` invocation (e.g. "--no-merges").
+func Load(since, until string, args ...string) ([]Commit, error) {
+	cmdArgs := []string{"log", "--format=" + format}
+	if since != "" {
+		cmdArgs = append(cmdArgs, "--after", since)
+	}
+	if until != "" {
+		cmdArgs = append(cmdArgs, "--before", until)
+	}
+	cmdArgs = append(cmdArgs, args...)
+
+	cmd := exec.Command("git", cmdArgs...)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return Parse(string(out))
+}
+
+// Parse splits raw `git log --format` output (using the field/record
+// separator convention documented on `format`) into Commits.
+func Parse(log string) ([]Commit, error) {
+	log = strings.Trim(log, "\n\x1e")
+	if log == "" {
+		return nil, nil
+	}
+
+	var commits []Commit
+	for _, record := range strings.Split(log, "\x1e") {
+		record = strings.TrimPrefix(record, "\n")
+		fields := strings.SplitN(record, "\x1f", fieldCount)
+		if len(fields) != fieldCount {
+			return nil, fmt.Errorf("gitparse: malformed record, expected %d fields, got %d", fieldCount, len(fields))
+		}
+
+		authorDate, err := time.Parse(time.RFC3339, fields[5])
+		if err != nil {
+			return nil, fmt.Errorf("gitparse: author date: %s", err)
+		}
+		committerDate, err := time.Parse(time.RFC3339, fields[6])
+		if err != nil {
+			return nil, fmt.Errorf("gitparse: committer date: %s", err)
+		}
+
+		var parents []string
+		if fields[7] != "" {
+			parents = strings.Split(fields[7], " ")
+		}
+
+		body := strings.TrimPrefix(strings.TrimPrefix(fields[9], fields[8]), "\n")
+		body = strings.Trim(body, "\n")
+
+		commits = append(commits, Commit{
+			Hash:          fields[0],
+			Author:        Identity{Name: fields[1], Email: fields[2]},
+			Committer:     Identity{Name: fields[3], Email: fields[4]},
+			AuthorDate:    authorDate,
+			CommitterDate: committerDate,
+			Parents:       parents,
+			Subject:       fields[8],
+			Body:          body,
+		})
+	}
+	return commits, nil
+}
@@ -0,0 +1,44 @@
+package gitparse
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	log := "abc123\x1fJane Doe\x1fjane@example.com\x1fJane Doe\x1fjane@example.com\x1f" +
+		"2014-07-11T00:00:00-07:00\x1f2014-07-11T00:00:00-07:00\x1f\x1f" +
+		"feat(api): add widgets\x1ffeat(api): add widgets\n\nCloses #1\x1e"
+
+	commits, err := Parse(log)
+	if err != nil {
+		t.Fatalf("Parse returned an error: %s", err)
+	}
+	if len(commits) != 1 {
+		t.Fatalf("expected 1 commit, got %d", len(commits))
+	}
+
+	c := commits[0]
+	if c.Hash != "abc123" {
+		t.Errorf("expected hash abc123, got %s", c.Hash)
+	}
+	if c.Author.Email != "jane@example.com" {
+		t.Errorf("expected author email jane@example.com, got %s", c.Author.Email)
+	}
+	if len(c.Parents) != 0 {
+		t.Errorf("expected no parents, got %v", c.Parents)
+	}
+	if c.Subject != "feat(api): add widgets" {
+		t.Errorf("expected subject %q, got %q", "feat(api): add widgets", c.Subject)
+	}
+	if c.Body != "Closes #1" {
+		t.Errorf("expected body %q, got %q", "Closes #1", c.Body)
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	commits, err := Parse("")
+	if err != nil {
+		t.Fatalf("Parse returned an error: %s", err)
+	}
+	if len(commits) != 0 {
+		t.Errorf("expected no commits, got %d", len(commits))
+	}
+}
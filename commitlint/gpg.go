@@ -0,0 +1,66 @@
+package commitlint
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// LoadSignatures shells out to `git log --format=%G?%x1f%H` as a side
+// channel to get each commit's signature status, since that
+// information isn't available through the regular `--format` fields used
+// to build Commit values. It returns a map of commit hash to status: "G"
+// (good), "U" (good, unknown validity), "B" (bad), "N" (no signature),
+// among others documented under `git log`'s PRETTY FORMATS.
+func LoadSignatures(since, until string) (map[string]string, error) {
+	args := []string{"log", "--format=%G?%x1f%H"}
+	if since != "" {
+		args = append(args, "--after", since)
+	}
+	if until != "" {
+		args = append(args, "--before", until)
+	}
+	cmd := exec.Command("git", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.SplitN(line, "\x1f", 2)
+		if len(fields) != 2 {
+			continue
+		}
+		statuses[fields[1]] = fields[0]
+	}
+	return statuses, nil
+}
+
+// GPG requires each commit's signature status, as reported by
+// LoadSignatures, to be good ("G"), or optionally good with unknown
+// validity ("U"). It is opt-in, for release branches that need
+// provenance guarantees.
+type GPG struct {
+	Statuses     map[string]string
+	AllowUnknown bool
+}
+
+// Name implements Rule.
+func (r GPG) Name() string { return "gpg" }
+
+// Check implements Rule.
+func (r GPG) Check(c Commit) []Issue {
+	status := r.Statuses[c.Hash]
+	if status == "G" || (r.AllowUnknown && status == "U") {
+		return nil
+	}
+	return []Issue{{
+		Hash:    c.Hash,
+		Rule:    r.Name(),
+		Message: fmt.Sprintf("commit is not signed with a trusted GPG key (status %q)", status),
+	}}
+}
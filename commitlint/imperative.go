@@ -0,0 +1,86 @@
+package commitlint
+
+import (
+	"fmt"
+	"strings"
+)
+
+// imperativeSuggestions maps common non-imperative commit verbs to their
+// imperative form, for rules that can offer a fix.
+var imperativeSuggestions = map[string]string{
+	"added":   "add",
+	"adds":    "add",
+	"adding":  "add",
+	"fixes":   "fix",
+	"fixed":   "fix",
+	"fixing":  "fix",
+	"updates": "update",
+	"updated": "update",
+	"removes": "remove",
+	"removed": "remove",
+	"changes": "change",
+	"changed": "change",
+}
+
+// nonImperative is a curated denylist of common non-imperative forms,
+// checked alongside the "ed"/"ing" suffix check. Entries such as "adds" or
+// "fixes" don't end in "ed"/"ing", so they'd otherwise slip past it.
+var nonImperative = map[string]bool{
+	"added":   true,
+	"adds":    true,
+	"adding":  true,
+	"fixes":   true,
+	"fixed":   true,
+	"updates": true,
+	"updated": true,
+	"removes": true,
+	"removed": true,
+	"changes": true,
+	"changed": true,
+}
+
+// ImperativeMood rejects subjects whose first word (after any
+// `type(scope): ` prefix) is not in the imperative mood, e.g. "added" or
+// "fixing" instead of "add"/"fix". Allowlist overrides words that would
+// otherwise be flagged by the "ed"/"ing" suffix heuristic, such as "wed"
+// or "bed".
+type ImperativeMood struct {
+	Allowlist []string
+}
+
+// Name implements Rule.
+func (r ImperativeMood) Name() string { return "imperative-mood" }
+
+// Check implements Rule.
+func (r ImperativeMood) Check(c Commit) []Issue {
+	subject := c.Subject
+	if loc := conventionalPrefix.FindStringIndex(subject); loc != nil {
+		subject = subject[loc[1]:]
+	}
+
+	fields := strings.Fields(subject)
+	if len(fields) == 0 {
+		return nil
+	}
+	word := strings.ToLower(fields[0])
+
+	for _, allowed := range r.Allowlist {
+		if word == strings.ToLower(allowed) {
+			return nil
+		}
+	}
+
+	if !nonImperative[word] && !strings.HasSuffix(word, "ed") && !strings.HasSuffix(word, "ing") {
+		return nil
+	}
+
+	msg := fmt.Sprintf("commit subject must use the imperative mood, found %q", word)
+	if suggestion, ok := imperativeSuggestions[word]; ok {
+		msg = fmt.Sprintf("%s (use %q instead)", msg, suggestion)
+	}
+	return []Issue{{
+		Hash:    c.Hash,
+		Rule:    r.Name(),
+		Message: msg,
+	}}
+}
@@ -0,0 +1,24 @@
+package commitlint
+
+import "testing"
+
+func TestImperativeMood(t *testing.T) {
+	rule := ImperativeMood{Allowlist: []string{"wed"}}
+
+	cases := []struct {
+		subject string
+		wantErr bool
+	}{
+		{"feat(api): add widgets", false},
+		{"feat(api): added widgets", true},
+		{"fix(api): fixing the thing", true},
+		{"chore(release): wed the branches", false},
+	}
+
+	for _, c := range cases {
+		issues := rule.Check(Commit{Subject: c.subject})
+		if got := len(issues) > 0; got != c.wantErr {
+			t.Errorf("Check(%q): got issues=%v, want issues=%v", c.subject, issues, c.wantErr)
+		}
+	}
+}
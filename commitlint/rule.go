@@ -0,0 +1,27 @@
+package commitlint
+
+import "fmt"
+
+// Issue describes a single rule violation raised against a commit.
+type Issue struct {
+	Hash    string
+	Rule    string
+	Message string
+}
+
+// String formats the issue as `hash: rule: message`, or `rule: message`
+// when the commit has no hash yet, for both `t.Errorf` and CLI output.
+func (i Issue) String() string {
+	if i.Hash == "" {
+		return fmt.Sprintf("%s: %s", i.Rule, i.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", i.Hash, i.Rule, i.Message)
+}
+
+// Rule checks a single commit and reports zero or more issues.
+type Rule interface {
+	// Name identifies the rule in Issues it raises, e.g. "subject-max-len".
+	Name() string
+	// Check inspects a commit and returns any issues found.
+	Check(c Commit) []Issue
+}
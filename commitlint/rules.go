@@ -0,0 +1,147 @@
+package commitlint
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// SubjectRegex rejects subjects that do not match Pattern.
+type SubjectRegex struct {
+	Pattern *regexp.Regexp
+}
+
+// Name implements Rule.
+func (r SubjectRegex) Name() string { return "subject-regex" }
+
+// Check implements Rule.
+func (r SubjectRegex) Check(c Commit) []Issue {
+	if r.Pattern.MatchString(c.Subject) {
+		return nil
+	}
+	return []Issue{{
+		Hash:    c.Hash,
+		Rule:    r.Name(),
+		Message: fmt.Sprintf("not a valid subject: %s", c.Subject),
+	}}
+}
+
+// SubjectMaxLen rejects subjects longer than Max characters.
+type SubjectMaxLen struct {
+	Max int
+}
+
+// Name implements Rule.
+func (r SubjectMaxLen) Name() string { return "subject-max-len" }
+
+// Check implements Rule.
+func (r SubjectMaxLen) Check(c Commit) []Issue {
+	if len(c.Subject) <= r.Max {
+		return nil
+	}
+	return []Issue{{
+		Hash:    c.Hash,
+		Rule:    r.Name(),
+		Message: fmt.Sprintf("subject cannot be longer than %d characters in length: %s", r.Max, c.Subject),
+	}}
+}
+
+// SubjectMinLen rejects subjects shorter than Min characters.
+type SubjectMinLen struct {
+	Min int
+}
+
+// Name implements Rule.
+func (r SubjectMinLen) Name() string { return "subject-min-len" }
+
+// Check implements Rule.
+func (r SubjectMinLen) Check(c Commit) []Issue {
+	if len(c.Subject) >= r.Min {
+		return nil
+	}
+	return []Issue{{
+		Hash:    c.Hash,
+		Rule:    r.Name(),
+		Message: fmt.Sprintf("subject cannot be shorter than %d characters in length: %s", r.Min, c.Subject),
+	}}
+}
+
+// BodyRegex rejects bodies that do not match Pattern. An empty body never
+// triggers this rule, since not every commit needs a body.
+type BodyRegex struct {
+	Pattern *regexp.Regexp
+}
+
+// Name implements Rule.
+func (r BodyRegex) Name() string { return "body-regex" }
+
+// Check implements Rule.
+func (r BodyRegex) Check(c Commit) []Issue {
+	if c.Body == "" || r.Pattern.MatchString(c.Body) {
+		return nil
+	}
+	return []Issue{{
+		Hash:    c.Hash,
+		Rule:    r.Name(),
+		Message: fmt.Sprintf("body does not match required pattern: %s", c.Body),
+	}}
+}
+
+// BodyMaxLen rejects any non-indented body or subject line longer than Max
+// characters. Lines indented with four spaces are assumed to be quoted
+// source code and are exempt, matching the original style guide.
+type BodyMaxLen struct {
+	Max int
+}
+
+// Name implements Rule.
+func (r BodyMaxLen) Name() string { return "body-max-len" }
+
+// Check implements Rule.
+func (r BodyMaxLen) Check(c Commit) []Issue {
+	for _, line := range Lines(c) {
+		if len(line) >= 4 && line[:4] == "    " {
+			continue
+		}
+		if len(line) > r.Max {
+			return []Issue{{
+				Hash:    c.Hash,
+				Rule:    r.Name(),
+				Message: fmt.Sprintf("lines cannot be longer than %d characters in length: %s", r.Max, c.Subject),
+			}}
+		}
+	}
+	return nil
+}
+
+// ConventionalType rejects subjects whose `type(scope):` prefix is not one
+// of Types.
+type ConventionalType struct {
+	Types []string
+}
+
+// Name implements Rule.
+func (r ConventionalType) Name() string { return "conventional-type" }
+
+var conventionalPrefix = regexp.MustCompile(`^([a-z]+)\(.+\): `)
+
+// Check implements Rule.
+func (r ConventionalType) Check(c Commit) []Issue {
+	matches := conventionalPrefix.FindStringSubmatch(c.Subject)
+	if matches == nil {
+		return []Issue{{
+			Hash:    c.Hash,
+			Rule:    r.Name(),
+			Message: fmt.Sprintf("subject is missing a type(scope): prefix: %s", c.Subject),
+		}}
+	}
+	for _, t := range r.Types {
+		if matches[1] == t {
+			return nil
+		}
+	}
+	return []Issue{{
+		Hash:    c.Hash,
+		Rule:    r.Name(),
+		Message: fmt.Sprintf("unrecognized commit type %q: %s", matches[1], c.Subject),
+	}}
+}
@@ -0,0 +1,27 @@
+package commitlint
+
+import "github.com/deis/deis/commitlint/gitparse"
+
+// PolicyDate is the date Deis' commit style guide went into effect. It is
+// the default `since` bound, so commits before it are not linted unless the
+// config sets an earlier `since`.
+const PolicyDate = "2014-07-11"
+
+// LoadCommits shells out to `git log` via package gitparse and returns
+// every commit between `since` and `until`. An empty `until` means up to
+// HEAD.
+func LoadCommits(since, until string) ([]Commit, error) {
+	return gitparse.Load(since, until)
+}
+
+// Run checks every commit against every rule and returns all issues found,
+// in commit order.
+func Run(commits []Commit, rules []Rule) []Issue {
+	var issues []Issue
+	for _, c := range commits {
+		for _, rule := range rules {
+			issues = append(issues, rule.Check(c)...)
+		}
+	}
+	return issues
+}